@@ -0,0 +1,192 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc"
+
+	"github.com/Gianguyen1234/inventory-service-golang/internal/command"
+	"github.com/Gianguyen1234/inventory-service-golang/internal/db"
+	"github.com/Gianguyen1234/inventory-service-golang/internal/grpc/inventoryv1"
+	"github.com/Gianguyen1234/inventory-service-golang/internal/grpcapi"
+	"github.com/Gianguyen1234/inventory-service-golang/internal/httpapi"
+	"github.com/Gianguyen1234/inventory-service-golang/internal/logging"
+	"github.com/Gianguyen1234/inventory-service-golang/internal/query"
+	"github.com/Gianguyen1234/inventory-service-golang/internal/reservation"
+	"github.com/Gianguyen1234/inventory-service-golang/internal/service"
+	"github.com/Gianguyen1234/inventory-service-golang/internal/transport"
+)
+
+var mainLogger = logging.With("main")
+
+// defaultReservationTTL is how long a stock reservation may stay pending
+// before Watcher releases it, unless overridden by RESERVATION_TTL.
+const defaultReservationTTL = 5 * time.Minute
+
+func kafkaBrokers() []string {
+	if brokers := os.Getenv("KAFKA_BROKERS"); brokers != "" {
+		return strings.Split(brokers, ",")
+	}
+	return []string{"localhost:9092"}
+}
+
+func reservationTTL() time.Duration {
+	if raw := os.Getenv("RESERVATION_TTL"); raw != "" {
+		if d, err := time.ParseDuration(raw); err == nil {
+			return d
+		}
+	}
+	return defaultReservationTTL
+}
+
+func grpcPort() string {
+	if port := os.Getenv("GRPC_PORT"); port != "" {
+		return port
+	}
+	return "9090"
+}
+
+func newRedisClient() *redis.Client {
+	addr := os.Getenv("REDIS_ADDR")
+	if addr == "" {
+		addr = "localhost:6379"
+	}
+	return redis.NewClient(&redis.Options{Addr: addr})
+}
+
+// startKafkaBroker wires the command bus, outbox dispatcher and Kafka
+// OrderCreatedEvent consumer together. Used when BROKER=kafka (the default).
+func startKafkaBroker(ctx context.Context) *command.Bus {
+	brokers := kafkaBrokers()
+
+	commandBus := command.NewBus(nil)
+	dispatcher := command.NewDispatcher(transport.NewKafkaPublisher(brokers), 500*time.Millisecond)
+	go dispatcher.Run(ctx)
+	go command.ConsumeOrderCreated(commandBus, brokers)
+
+	return commandBus
+}
+
+// startNatsBroker wires the same flow on top of NATS JetStream, additionally
+// backing reservations with a KV ledger so unconfirmed orders auto-release
+// their stock after RESERVATION_TTL. Used when BROKER=nats. Returns js too,
+// so the caller can also point query.Projector at the same JetStream
+// connection instead of a Kafka reader.
+func startNatsBroker(ctx context.Context) (*command.Bus, jetstream.JetStream, error) {
+	natsURL := os.Getenv("NATS_URL")
+	if natsURL == "" {
+		natsURL = nats.DefaultURL
+	}
+	nc, err := nats.Connect(natsURL)
+	if err != nil {
+		return nil, nil, err
+	}
+	js, err := jetstream.New(nc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ledger, err := reservation.NewLedger(ctx, js, reservationTTL())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	commandBus := command.NewBus(ledger)
+	dispatcher := command.NewDispatcher(transport.NewNatsPublisher(js), 500*time.Millisecond)
+	go dispatcher.Run(ctx)
+
+	release := func(ctx context.Context, orderID, productID, quantity int) error {
+		return commandBus.Dispatch(ctx, command.ReleaseReservationCommand{
+			OrderID: orderID, ProductID: productID, Quantity: quantity,
+		})
+	}
+	watcher := reservation.NewWatcher(ledger, release)
+	go watcher.Run(ctx)
+
+	go func() {
+		if err := reservation.ConsumeOrderCreated(ctx, js, commandBus); err != nil {
+			mainLogger.Error("nats order-created consumer stopped", "error", err)
+		}
+	}()
+	go func() {
+		if err := reservation.ConsumeOrderConfirmed(ctx, js, ledger); err != nil {
+			mainLogger.Error("nats order-confirmed consumer stopped", "error", err)
+		}
+	}()
+
+	return commandBus, js, nil
+}
+
+// startGrpcServer serves inventoryv1.InventoryService on GRPC_PORT (default
+// 9090), sharing inventory and hub with the HTTP transport so both speak
+// identical business logic.
+func startGrpcServer(inventory *service.Inventory, hub *service.Hub) {
+	lis, err := net.Listen("tcp", ":"+grpcPort())
+	if err != nil {
+		mainLogger.Error("cannot listen for gRPC", "error", err)
+		os.Exit(1)
+	}
+
+	srv := grpc.NewServer()
+	inventoryv1.RegisterInventoryServiceServer(srv, grpcapi.NewServer(inventory, hub))
+
+	mainLogger.Info("gRPC server listening", "addr", lis.Addr().String())
+	if err := srv.Serve(lis); err != nil {
+		mainLogger.Error("gRPC server stopped", "error", err)
+	}
+}
+
+func main() {
+	start := time.Now()
+	db.Connect()
+	ctx := context.Background()
+
+	var commandBus *command.Bus
+	var natsJS jetstream.JetStream
+	if os.Getenv("BROKER") == "nats" {
+		bus, js, err := startNatsBroker(ctx)
+		if err != nil {
+			mainLogger.Error("cannot start NATS broker", "error", err)
+			os.Exit(1)
+		}
+		commandBus = bus
+		natsJS = js
+	} else {
+		commandBus = startKafkaBroker(ctx)
+	}
+
+	store := query.NewStore(newRedisClient())
+	queryBus := query.NewBus(store)
+	hub := service.NewHub()
+	projector := query.NewProjector(store, hub)
+	if natsJS != nil {
+		go func() {
+			if err := projector.RunNats(ctx, natsJS); err != nil {
+				mainLogger.Error("nats projector stopped", "error", err)
+			}
+		}()
+	} else {
+		go projector.Run(kafkaBrokers())
+	}
+
+	inventory := service.New(commandBus, queryBus)
+	go startGrpcServer(inventory, hub)
+
+	handlers := httpapi.NewHandlers(inventory)
+	r := httpapi.NewRouter(handlers)
+
+	mainLogger.Info("startup complete", "elapsed", time.Since(start).String())
+	mainLogger.Info("inventory-service running", "addr", ":8086")
+	if err := http.ListenAndServe(":8086", r); err != nil {
+		mainLogger.Error("http server stopped", "error", err)
+		os.Exit(1)
+	}
+}
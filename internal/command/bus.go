@@ -0,0 +1,47 @@
+// Package command is the write side of the service: it owns every mutation
+// of the authoritative "inventories" table, emitting outbox rows in the
+// same transaction so a dispatcher can publish them to Kafka exactly once.
+package command
+
+import (
+	"context"
+	"fmt"
+)
+
+// ReservationRecorder tracks a pending stock reservation so it can be
+// auto-released if it's never confirmed. *reservation.Ledger implements
+// this; it's expressed as an interface here so command doesn't import
+// internal/reservation.
+type ReservationRecorder interface {
+	Reserve(ctx context.Context, orderID, productID, quantity int) error
+}
+
+// Bus dispatches typed commands to their handler. HTTP handlers and the
+// Kafka consumer both go through Bus rather than touching the DB directly,
+// so business rules live in one place.
+type Bus struct {
+	recorder ReservationRecorder
+}
+
+// NewBus builds a command Bus. recorder may be nil, in which case
+// successful reservations are not tracked for TTL-based auto-release (the
+// Kafka-only deployment mode).
+func NewBus(recorder ReservationRecorder) *Bus {
+	return &Bus{recorder: recorder}
+}
+
+// Dispatch routes cmd to its handler based on its concrete type.
+func (b *Bus) Dispatch(ctx context.Context, cmd any) error {
+	switch c := cmd.(type) {
+	case CreateInventoryCommand:
+		return handleCreateInventory(ctx, c)
+	case AdjustQuantityCommand:
+		return handleAdjustQuantity(ctx, c)
+	case ReserveStockCommand:
+		return b.handleReserveStock(ctx, c)
+	case ReleaseReservationCommand:
+		return handleReleaseReservation(ctx, c)
+	default:
+		return fmt.Errorf("command: no handler registered for %T", cmd)
+	}
+}
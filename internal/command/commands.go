@@ -0,0 +1,31 @@
+package command
+
+// CreateInventoryCommand creates a brand new inventory row for a product.
+type CreateInventoryCommand struct {
+	ProductID int
+	Quantity  int
+}
+
+// AdjustQuantityCommand overwrites the quantity for an existing product,
+// e.g. from the PUT /inventory/{product_id} handler.
+type AdjustQuantityCommand struct {
+	ProductID int
+	Quantity  int
+}
+
+// ReserveStockCommand decrements stock for an order placed upstream. It is
+// issued from the OrderCreatedEvent Kafka handler rather than HTTP.
+type ReserveStockCommand struct {
+	OrderID   int
+	ProductID int
+	Quantity  int
+}
+
+// ReleaseReservationCommand re-increments stock for a reservation that
+// expired without ever being confirmed. It is issued by the reservation
+// ledger's TTL watcher, not by HTTP or an order event.
+type ReleaseReservationCommand struct {
+	OrderID   int
+	ProductID int
+	Quantity  int
+}
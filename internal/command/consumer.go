@@ -0,0 +1,85 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/segmentio/kafka-go"
+
+	"github.com/Gianguyen1234/inventory-service-golang/internal/domain"
+	"github.com/Gianguyen1234/inventory-service-golang/internal/logging"
+	"github.com/Gianguyen1234/inventory-service-golang/internal/metrics"
+	"github.com/Gianguyen1234/inventory-service-golang/internal/transport"
+)
+
+var consumerLogger = logging.With("kafka")
+
+// ConsumeOrderCreated reads OrderCreatedEvent off the "orders" topic and
+// translates each one into a ReserveStockCommand on bus. Offsets are
+// committed manually, only after the command has either succeeded or been
+// forwarded to the DLQ, so a crash mid-processing redelivers the message
+// instead of silently losing it; handleReserveStock's processed_events
+// check then makes that redelivery a no-op rather than a double-decrement.
+// It is meant to run in its own goroutine for the lifetime of the process.
+func ConsumeOrderCreated(bus *Bus, brokers []string) {
+	r := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:        brokers,
+		Topic:          "orders",
+		GroupID:        "inventory-service-group",
+		CommitInterval: 0, // manual commits, see CommitMessages below
+	})
+	dlq := transport.NewKafkaPublisher(brokers)
+	attempts := maxAttempts()
+
+	for {
+		m, err := r.FetchMessage(context.Background())
+		if err != nil {
+			consumerLogger.Error("kafka read error", "error", err)
+			continue
+		}
+
+		var event domain.OrderCreatedEvent
+		if err := json.Unmarshal(m.Value, &event); err != nil {
+			consumerLogger.Error("json unmarshal error", "error", err, "topic", m.Topic)
+			metrics.KafkaMessagesConsumedTotal.WithLabelValues(m.Topic, "decode_error").Inc()
+			if dlqErr := publishToDLQ(context.Background(), dlq, m.Topic, m.Partition, m.Offset, m.Key, m.Value, err, 1); dlqErr != nil {
+				consumerLogger.Error("dlq publish error", "error", dlqErr, "topic", m.Topic)
+				metrics.KafkaPublishErrorsTotal.WithLabelValues(dlqTopic()).Inc()
+			}
+			commit(r, m)
+			continue
+		}
+
+		consumerLogger.Info("received OrderCreatedEvent",
+			"order_id", event.OrderID, "product_id", event.ProductID)
+
+		cmd := ReserveStockCommand{
+			OrderID:   event.OrderID,
+			ProductID: event.ProductID,
+			Quantity:  event.Quantity,
+		}
+
+		dispatchErr := withRetry(context.Background(), attempts, func() error {
+			return bus.Dispatch(context.Background(), cmd)
+		})
+		if dispatchErr != nil {
+			consumerLogger.Error("reserve stock failed after retries",
+				"error", dispatchErr, "order_id", event.OrderID, "attempts", attempts)
+			metrics.KafkaMessagesConsumedTotal.WithLabelValues(m.Topic, "dlq").Inc()
+			if dlqErr := publishToDLQ(context.Background(), dlq, m.Topic, m.Partition, m.Offset, m.Key, m.Value, dispatchErr, attempts); dlqErr != nil {
+				consumerLogger.Error("dlq publish error", "error", dlqErr, "topic", m.Topic)
+				metrics.KafkaPublishErrorsTotal.WithLabelValues(dlqTopic()).Inc()
+			}
+		} else {
+			metrics.KafkaMessagesConsumedTotal.WithLabelValues(m.Topic, "ok").Inc()
+		}
+
+		commit(r, m)
+	}
+}
+
+func commit(r *kafka.Reader, m kafka.Message) {
+	if err := r.CommitMessages(context.Background(), m); err != nil {
+		consumerLogger.Error("kafka commit error", "error", err)
+	}
+}
@@ -0,0 +1,55 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/Gianguyen1234/inventory-service-golang/internal/transport"
+)
+
+// defaultDLQTopic is where undeliverable messages land once retries are
+// exhausted, unless overridden by DLQ_TOPIC.
+const defaultDLQTopic = "inventory-dlq"
+
+func dlqTopic() string {
+	if topic := os.Getenv("DLQ_TOPIC"); topic != "" {
+		return topic
+	}
+	return defaultDLQTopic
+}
+
+// dlqEnvelope carries the raw, unprocessable message plus enough metadata
+// to diagnose and potentially replay it later.
+type dlqEnvelope struct {
+	Topic     string    `json:"topic"`
+	Partition int       `json:"partition"`
+	Offset    int64     `json:"offset"`
+	Key       []byte    `json:"key,omitempty"`
+	Value     []byte    `json:"value"`
+	Error     string    `json:"error"`
+	Attempts  int       `json:"attempts"`
+	FailedAt  time.Time `json:"failed_at"`
+}
+
+// publishToDLQ forwards a message that exhausted its retries to the DLQ
+// topic via publisher, tagging it with the failure that caused it to land
+// there.
+func publishToDLQ(ctx context.Context, publisher transport.Publisher, topic string, partition int, offset int64, key, value []byte, cause error, attempts int) error {
+	envelope := dlqEnvelope{
+		Topic:     topic,
+		Partition: partition,
+		Offset:    offset,
+		Key:       key,
+		Value:     value,
+		Error:     cause.Error(),
+		Attempts:  attempts,
+		FailedAt:  time.Now(),
+	}
+	data, err := json.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+	return publisher.Publish(ctx, dlqTopic(), data)
+}
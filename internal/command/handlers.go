@@ -0,0 +1,202 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/Gianguyen1234/inventory-service-golang/internal/db"
+	"github.com/Gianguyen1234/inventory-service-golang/internal/domain"
+	"github.com/Gianguyen1234/inventory-service-golang/internal/logging"
+	"github.com/Gianguyen1234/inventory-service-golang/internal/metrics"
+)
+
+// ErrInsufficientStock is returned by handleReserveStock when there isn't
+// enough quantity on hand to satisfy an order.
+var ErrInsufficientStock = errors.New("insufficient stock")
+
+var handlerLogger = logging.With("command")
+
+func observeStockQuantity(productID, quantity int) {
+	metrics.StockQuantity.WithLabelValues(strconv.Itoa(productID)).Set(float64(quantity))
+}
+
+func handleCreateInventory(ctx context.Context, c CreateInventoryCommand) error {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx,
+		`INSERT INTO inventories (product_id, quantity, updated_at) VALUES ($1, $2, $3)`,
+		c.ProductID, c.Quantity, time.Now()); err != nil {
+		return err
+	}
+
+	if err := writeOutbox(ctx, tx, "inventory-changed",
+		domain.InventoryChangedEvent{ProductID: c.ProductID, Quantity: c.Quantity}); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	observeStockQuantity(c.ProductID, c.Quantity)
+	return nil
+}
+
+func handleAdjustQuantity(ctx context.Context, c AdjustQuantityCommand) error {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx,
+		`UPDATE inventories SET quantity = $1, updated_at = $2 WHERE product_id = $3`,
+		c.Quantity, time.Now(), c.ProductID); err != nil {
+		return err
+	}
+
+	if err := writeOutbox(ctx, tx, "inventory-changed",
+		domain.InventoryChangedEvent{ProductID: c.ProductID, Quantity: c.Quantity}); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	observeStockQuantity(c.ProductID, c.Quantity)
+	return nil
+}
+
+// handleReserveStock is invoked from the OrderCreatedEvent handler. It
+// decrements stock and stages both the outbox "inventory-changed" row and
+// the reservation outcome ("inventory-reserved"/"inventory-failed") in the
+// same transaction, so the dispatcher is the only thing that ever talks to
+// the broker for this flow. On success it also hands the reservation to
+// b.recorder (when configured) so it can be auto-released if it times out.
+//
+// The order_id is recorded in processed_events in the same transaction as
+// the stock mutation, so a redelivered OrderCreatedEvent (e.g. after a
+// crash between the DB commit and the Kafka offset commit) is a no-op
+// rather than double-decrementing stock.
+func (b *Bus) handleReserveStock(ctx context.Context, c ReserveStockCommand) error {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var alreadyProcessed bool
+	if err := tx.QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM processed_events WHERE order_id = $1)`, c.OrderID).Scan(&alreadyProcessed); err != nil {
+		return err
+	}
+	if alreadyProcessed {
+		handlerLogger.Info("order already processed, skipping", "order_id", c.OrderID)
+		return tx.Commit(ctx)
+	}
+
+	var quantity int
+	err = tx.QueryRow(ctx,
+		`SELECT quantity FROM inventories WHERE product_id = $1 FOR UPDATE`, c.ProductID).Scan(&quantity)
+	if err != nil {
+		_ = writeOutbox(ctx, tx, "inventory-failed", domain.InventoryReservedEvent{
+			OrderID: c.OrderID, Status: "FAILED", Message: "Product not found",
+		})
+		_ = recordProcessed(ctx, tx, c.OrderID, "PRODUCT_NOT_FOUND")
+		return tx.Commit(ctx)
+	}
+
+	if quantity < c.Quantity {
+		if err := writeOutbox(ctx, tx, "inventory-failed", domain.InventoryReservedEvent{
+			OrderID: c.OrderID, Status: "FAILED", Message: "Not enough stock",
+		}); err != nil {
+			return err
+		}
+		if err := recordProcessed(ctx, tx, c.OrderID, "INSUFFICIENT_STOCK"); err != nil {
+			return err
+		}
+		return tx.Commit(ctx)
+	}
+
+	newQuantity := quantity - c.Quantity
+	if _, err := tx.Exec(ctx,
+		`UPDATE inventories SET quantity = $1, updated_at = $2 WHERE product_id = $3`,
+		newQuantity, time.Now(), c.ProductID); err != nil {
+		return err
+	}
+
+	if err := writeOutbox(ctx, tx, "inventory-changed",
+		domain.InventoryChangedEvent{ProductID: c.ProductID, Quantity: newQuantity}); err != nil {
+		return err
+	}
+	if err := writeOutbox(ctx, tx, "inventory-reserved", domain.InventoryReservedEvent{
+		OrderID: c.OrderID, Status: "RESERVED", Message: "Reserved successfully",
+	}); err != nil {
+		return err
+	}
+	if err := recordProcessed(ctx, tx, c.OrderID, "RESERVED"); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	handlerLogger.Info("reserved stock", "order_id", c.OrderID, "product_id", c.ProductID)
+	observeStockQuantity(c.ProductID, newQuantity)
+
+	if b.recorder != nil {
+		if err := b.recorder.Reserve(ctx, c.OrderID, c.ProductID, c.Quantity); err != nil {
+			handlerLogger.Error("reservation ledger write failed", "error", err, "order_id", c.OrderID)
+		} else {
+			metrics.ReservationsPending.Inc()
+		}
+	}
+	return nil
+}
+
+// handleReleaseReservation re-increments stock for a reservation that timed
+// out without being confirmed. It is invoked by the reservation ledger's
+// expiry watcher, never directly from HTTP or an order event.
+func handleReleaseReservation(ctx context.Context, c ReleaseReservationCommand) error {
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var quantity int
+	if err := tx.QueryRow(ctx,
+		`SELECT quantity FROM inventories WHERE product_id = $1 FOR UPDATE`, c.ProductID).Scan(&quantity); err != nil {
+		return err
+	}
+
+	newQuantity := quantity + c.Quantity
+	if _, err := tx.Exec(ctx,
+		`UPDATE inventories SET quantity = $1, updated_at = $2 WHERE product_id = $3`,
+		newQuantity, time.Now(), c.ProductID); err != nil {
+		return err
+	}
+
+	if err := writeOutbox(ctx, tx, "inventory-changed",
+		domain.InventoryChangedEvent{ProductID: c.ProductID, Quantity: newQuantity}); err != nil {
+		return err
+	}
+	if err := writeOutbox(ctx, tx, "inventory-released", domain.InventoryReleasedEvent{
+		OrderID: c.OrderID, ProductID: c.ProductID, Message: "Reservation expired unconfirmed",
+	}); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	handlerLogger.Info("reservation expired, stock released", "order_id", c.OrderID, "product_id", c.ProductID)
+	observeStockQuantity(c.ProductID, newQuantity)
+	metrics.ReservationsPending.Dec()
+	return nil
+}
@@ -0,0 +1,113 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Gianguyen1234/inventory-service-golang/internal/db"
+	"github.com/Gianguyen1234/inventory-service-golang/internal/logging"
+	"github.com/Gianguyen1234/inventory-service-golang/internal/metrics"
+	"github.com/Gianguyen1234/inventory-service-golang/internal/transport"
+)
+
+var outboxLogger = logging.With("outbox")
+
+// outboxRow mirrors a row of the "outbox" table.
+type outboxRow struct {
+	ID        int64
+	Topic     string
+	Payload   []byte
+	CreatedAt time.Time
+}
+
+// writeOutbox inserts a pending outbox row as part of tx, so the event is
+// only ever recorded if the authoritative write it describes also commits.
+func writeOutbox(ctx context.Context, tx pgx.Tx, topic string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx,
+		`INSERT INTO outbox (topic, payload, created_at) VALUES ($1, $2, $3)`,
+		topic, data, time.Now())
+	return err
+}
+
+// recordProcessed marks order_id as handled as part of tx, making the
+// ReserveStockCommand handler idempotent under Kafka redelivery: a second
+// delivery of the same order_id finds the row already present and skips
+// the mutation instead of double-decrementing stock.
+func recordProcessed(ctx context.Context, tx pgx.Tx, orderID int, result string) error {
+	_, err := tx.Exec(ctx,
+		`INSERT INTO processed_events (order_id, result, processed_at) VALUES ($1, $2, $3)`,
+		orderID, result, time.Now())
+	return err
+}
+
+// Dispatcher polls the outbox table and publishes unpublished rows exactly
+// once through publisher, decoupling the publish from the originating DB
+// transaction. publisher is a transport.Publisher so the same Dispatcher
+// works whether the deployment is configured for Kafka or NATS JetStream.
+type Dispatcher struct {
+	publisher    transport.Publisher
+	pollInterval time.Duration
+}
+
+// NewDispatcher builds a Dispatcher that publishes through publisher, using
+// the outbox's own topic column per message.
+func NewDispatcher(publisher transport.Publisher, pollInterval time.Duration) *Dispatcher {
+	return &Dispatcher{publisher: publisher, pollInterval: pollInterval}
+}
+
+// Run polls forever, publishing and marking outbox rows as dispatched. It is
+// meant to be started with `go dispatcher.Run(ctx)`.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := d.dispatchPending(ctx); err != nil {
+				outboxLogger.Error("outbox dispatch error", "error", err)
+			}
+		}
+	}
+}
+
+func (d *Dispatcher) dispatchPending(ctx context.Context) error {
+	rows, err := db.Pool.Query(ctx,
+		`SELECT id, topic, payload, created_at FROM outbox WHERE dispatched_at IS NULL ORDER BY id LIMIT 100`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var pending []outboxRow
+	for rows.Next() {
+		var r outboxRow
+		if err := rows.Scan(&r.ID, &r.Topic, &r.Payload, &r.CreatedAt); err != nil {
+			return err
+		}
+		pending = append(pending, r)
+	}
+
+	for _, r := range pending {
+		err := d.publisher.Publish(ctx, r.Topic, r.Payload)
+		if err != nil {
+			outboxLogger.Error("outbox publish error", "error", err, "topic", r.Topic)
+			metrics.KafkaPublishErrorsTotal.WithLabelValues(r.Topic).Inc()
+			continue
+		}
+		if _, err := db.Pool.Exec(ctx,
+			`UPDATE outbox SET dispatched_at = $1 WHERE id = $2`, time.Now(), r.ID); err != nil {
+			outboxLogger.Error("outbox mark-dispatched error", "error", err, "topic", r.Topic)
+		}
+	}
+	return nil
+}
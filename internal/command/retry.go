@@ -0,0 +1,48 @@
+package command
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"time"
+)
+
+// defaultMaxAttempts is how many times a message is retried before it is
+// sent to the DLQ, unless overridden by RETRY_MAX_ATTEMPTS.
+const defaultMaxAttempts = 3
+
+// backoffSchedule is the delay before each retry after the first attempt:
+// 100ms, then 1s, then 10s.
+var backoffSchedule = []time.Duration{100 * time.Millisecond, 1 * time.Second, 10 * time.Second}
+
+func maxAttempts() int {
+	if raw := os.Getenv("RETRY_MAX_ATTEMPTS"); raw != "" {
+		if n, err := strconv.Atoi(raw); err == nil && n > 0 {
+			return n
+		}
+	}
+	return defaultMaxAttempts
+}
+
+// withRetry calls fn up to attempts times, sleeping per backoffSchedule
+// between tries, and returns the last error if every attempt failed.
+func withRetry(ctx context.Context, attempts int, fn func() error) error {
+	var err error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			delay := backoffSchedule[len(backoffSchedule)-1]
+			if attempt-1 < len(backoffSchedule) {
+				delay = backoffSchedule[attempt-1]
+			}
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if err = fn(); err == nil {
+			return nil
+		}
+	}
+	return err
+}
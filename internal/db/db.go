@@ -0,0 +1,39 @@
+// Package db owns the shared PostgreSQL connection pool used by both the
+// command and query sides.
+package db
+
+import (
+	"context"
+	"os"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/joho/godotenv"
+
+	"github.com/Gianguyen1234/inventory-service-golang/internal/logging"
+)
+
+// Pool is the process-wide PostgreSQL connection pool. It is populated by
+// Connect and read by both internal/command and internal/query.
+var Pool *pgxpool.Pool
+
+var logger = logging.With("db")
+
+// Connect loads .env, dials Postgres and stores the resulting pool in Pool.
+// Failures here are fatal: the service can't do anything useful without a
+// DB connection, so these errors go through logger and os.Exit(1) rather
+// than returning, to keep every startup failure on the same JSON log path.
+func Connect() {
+	if err := godotenv.Load(); err != nil {
+		logger.Error("error loading .env", "error", err)
+		os.Exit(1)
+	}
+
+	dbURL := os.Getenv("DATABASE_URL")
+	pool, err := pgxpool.New(context.Background(), dbURL)
+	if err != nil {
+		logger.Error("cannot connect to DB", "error", err)
+		os.Exit(1)
+	}
+	Pool = pool
+	logger.Info("connected to PostgreSQL")
+}
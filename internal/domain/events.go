@@ -0,0 +1,49 @@
+// Package domain holds the event and read-model types shared by the
+// command and query sides, so neither package has to import the other.
+package domain
+
+// OrderCreatedEvent is consumed from the "orders" Kafka topic.
+type OrderCreatedEvent struct {
+	OrderID   int     `json:"orderId"`
+	UserID    int     `json:"userId"`
+	ProductID int     `json:"productId"`
+	Quantity  int     `json:"quantity"`
+	Total     float64 `json:"total"`
+}
+
+// InventoryReservedEvent is published to "inventory-reserved" / "inventory-failed".
+type InventoryReservedEvent struct {
+	OrderID int    `json:"orderId"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// InventoryChangedEvent is published to "inventory-changed" any time the
+// authoritative quantity for a product is written on the command side. The
+// query side consumes it to keep its projection up to date.
+type InventoryChangedEvent struct {
+	ProductID int `json:"productId"`
+	Quantity  int `json:"quantity"`
+}
+
+// OrderConfirmedEvent marks a previously reserved order as settled. It
+// confirms the matching entry in the reservation ledger so the TTL watcher
+// doesn't release stock that was actually used.
+type OrderConfirmedEvent struct {
+	OrderID int `json:"orderId"`
+}
+
+// InventoryReleasedEvent is published to "inventory-released" when a
+// reservation expires without a matching OrderConfirmedEvent.
+type InventoryReleasedEvent struct {
+	OrderID   int    `json:"orderId"`
+	ProductID int    `json:"productId"`
+	Message   string `json:"message"`
+}
+
+// InventoryReadModel is the denormalized shape served by the query side.
+type InventoryReadModel struct {
+	ProductID int  `json:"product_id"`
+	Quantity  int  `json:"quantity"`
+	Available bool `json:"available"`
+}
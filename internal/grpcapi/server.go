@@ -0,0 +1,84 @@
+// Package grpcapi is the gRPC counterpart of internal/httpapi. It decodes
+// and encodes inventoryv1 messages and calls into service.Inventory for
+// every piece of actual logic, so the two transports can never drift.
+package grpcapi
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Gianguyen1234/inventory-service-golang/internal/domain"
+	"github.com/Gianguyen1234/inventory-service-golang/internal/grpc/inventoryv1"
+	"github.com/Gianguyen1234/inventory-service-golang/internal/logging"
+	"github.com/Gianguyen1234/inventory-service-golang/internal/service"
+)
+
+var grpcLogger = logging.With("grpc")
+
+// Server implements inventoryv1.InventoryServiceServer on top of
+// service.Inventory, streaming WatchInventory updates out of hub.
+type Server struct {
+	inventoryv1.UnimplementedInventoryServiceServer
+	inventory *service.Inventory
+	hub       *service.Hub
+}
+
+// NewServer builds a Server backed by inventory and hub.
+func NewServer(inventory *service.Inventory, hub *service.Hub) *Server {
+	return &Server{inventory: inventory, hub: hub}
+}
+
+func toProto(model domain.InventoryReadModel) *inventoryv1.Inventory {
+	return &inventoryv1.Inventory{
+		ProductId: int32(model.ProductID),
+		Quantity:  int32(model.Quantity),
+		Available: model.Available,
+	}
+}
+
+func (s *Server) GetInventory(ctx context.Context, req *inventoryv1.GetInventoryRequest) (*inventoryv1.Inventory, error) {
+	model, err := s.inventory.Get(ctx, strconv.Itoa(int(req.ProductId)))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "product not found")
+	}
+	return toProto(model), nil
+}
+
+func (s *Server) CreateInventory(ctx context.Context, req *inventoryv1.CreateInventoryRequest) (*inventoryv1.Inventory, error) {
+	model, err := s.inventory.Create(ctx, int(req.ProductId), int(req.Quantity))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "insert failed")
+	}
+	return toProto(model), nil
+}
+
+func (s *Server) UpdateInventory(ctx context.Context, req *inventoryv1.UpdateInventoryRequest) (*inventoryv1.Inventory, error) {
+	model, err := s.inventory.Update(ctx, int(req.ProductId), int(req.Quantity))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "update failed")
+	}
+	return toProto(model), nil
+}
+
+// WatchInventory streams every read-model update Hub observes for
+// req.ProductId until the client disconnects.
+func (s *Server) WatchInventory(req *inventoryv1.WatchInventoryRequest, stream inventoryv1.InventoryService_WatchInventoryServer) error {
+	updates, cancel := s.hub.Subscribe(int(req.ProductId))
+	defer cancel()
+
+	ctx := stream.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case model := <-updates:
+			if err := stream.Send(toProto(model)); err != nil {
+				grpcLogger.Error("watch send failed", "error", err, "product_id", req.ProductId)
+				return err
+			}
+		}
+	}
+}
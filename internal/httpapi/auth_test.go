@@ -0,0 +1,144 @@
+package httpapi
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/Gianguyen1234/inventory-service-golang/internal/runtime"
+)
+
+const testSecret = "test-secret"
+
+func signToken(t *testing.T, secret string, perms []string, exp time.Time) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims{
+		Permissions:      perms,
+		RegisteredClaims: jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(exp)},
+	})
+	signed, err := token.SignedString([]byte(secret))
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	return signed
+}
+
+// noneAlgToken hand-builds a token claiming "alg": "none" with no signature
+// at all, the classic forgery golang-jwt must refuse even though it parses
+// as a structurally valid JWT.
+func noneAlgToken(t *testing.T, perms []string) string {
+	t.Helper()
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+	payload, err := json.Marshal(map[string]any{
+		"permissions": perms,
+		"exp":         time.Now().Add(time.Hour).Unix(),
+	})
+	if err != nil {
+		t.Fatalf("marshal payload: %v", err)
+	}
+	return header + "." + base64.RawURLEncoding.EncodeToString(payload) + "."
+}
+
+// requestWithAuth builds a request against the given handler wrapped the
+// same way NewRouter wires it: corsMiddleware ahead of RequirePermission,
+// so CORS headers must still be present on a 401/403.
+func requestWithAuth(bearer string, permission string) *httptest.ResponseRecorder {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := corsMiddleware(RequirePermission(permission)(ok))
+
+	req := httptest.NewRequest(http.MethodPost, "/inventory", nil)
+	if bearer != "" {
+		req.Header.Set("Authorization", "Bearer "+bearer)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func assertCORSHeaders(t *testing.T, rec *httptest.ResponseRecorder) {
+	t.Helper()
+	if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "*" {
+		t.Errorf("Access-Control-Allow-Origin = %q, want \"*\"", got)
+	}
+	if got := rec.Header().Get("Access-Control-Allow-Headers"); got != "Content-Type, Authorization" {
+		t.Errorf("Access-Control-Allow-Headers = %q, want to include Authorization", got)
+	}
+}
+
+func TestRequirePermission_MissingToken(t *testing.T) {
+	t.Setenv("JWT_SECRET", testSecret)
+
+	rec := requestWithAuth("", runtime.PermissionInventoryCreate)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+	assertCORSHeaders(t, rec)
+}
+
+func TestRequirePermission_ExpiredToken(t *testing.T) {
+	t.Setenv("JWT_SECRET", testSecret)
+
+	token := signToken(t, testSecret, []string{runtime.PermissionInventoryCreate}, time.Now().Add(-time.Hour))
+	rec := requestWithAuth(token, runtime.PermissionInventoryCreate)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+	assertCORSHeaders(t, rec)
+}
+
+func TestRequirePermission_NoneAlgToken(t *testing.T) {
+	t.Setenv("JWT_SECRET", testSecret)
+
+	token := noneAlgToken(t, []string{runtime.PermissionInventoryCreate})
+	rec := requestWithAuth(token, runtime.PermissionInventoryCreate)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+	assertCORSHeaders(t, rec)
+}
+
+func TestRequirePermission_WrongSignature(t *testing.T) {
+	t.Setenv("JWT_SECRET", testSecret)
+
+	token := signToken(t, "a-different-secret", []string{runtime.PermissionInventoryCreate}, time.Now().Add(time.Hour))
+	rec := requestWithAuth(token, runtime.PermissionInventoryCreate)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+	assertCORSHeaders(t, rec)
+}
+
+func TestRequirePermission_ForbiddenPermission(t *testing.T) {
+	t.Setenv("JWT_SECRET", testSecret)
+
+	token := signToken(t, testSecret, []string{runtime.PermissionInventoryRead}, time.Now().Add(time.Hour))
+	rec := requestWithAuth(token, runtime.PermissionInventoryCreate)
+
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusForbidden)
+	}
+	assertCORSHeaders(t, rec)
+}
+
+func TestRequirePermission_GrantedPermission(t *testing.T) {
+	t.Setenv("JWT_SECRET", testSecret)
+
+	token := signToken(t, testSecret, []string{runtime.PermissionInventoryCreate}, time.Now().Add(time.Hour))
+	rec := requestWithAuth(token, runtime.PermissionInventoryCreate)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	assertCORSHeaders(t, rec)
+}
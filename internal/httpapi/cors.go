@@ -1,13 +1,16 @@
-// file: cors.go
-package main
+package httpapi
 
 import "net/http"
 
-// enableCORS sets CORS headers
+// enableCORS sets CORS headers. Authorization is allowed alongside
+// Content-Type so the browser preflight for an authenticated POST/PUT
+// (see auth.go's RequirePermission) actually succeeds; Vary: Origin is set
+// because the allowed origin (here, all of them) affects the response.
 func enableCORS(w http.ResponseWriter) {
 	w.Header().Set("Access-Control-Allow-Origin", "*")
 	w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, OPTIONS")
-	w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+	w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+	w.Header().Set("Vary", "Origin")
 }
 
 // corsMiddleware adds CORS headers to all responses, handles preflight
@@ -0,0 +1,77 @@
+// Package httpapi holds the mux HTTP handlers. They contain no business
+// logic themselves: they decode the request, call into service.Inventory,
+// and translate the result into a response.
+package httpapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/Gianguyen1234/inventory-service-golang/internal/service"
+)
+
+// Handlers bundles the shared Inventory service the HTTP layer calls into.
+type Handlers struct {
+	Inventory *service.Inventory
+}
+
+// NewHandlers builds a Handlers backed by the given Inventory service.
+func NewHandlers(inventory *service.Inventory) *Handlers {
+	return &Handlers{Inventory: inventory}
+}
+
+type inventoryRequest struct {
+	ProductID int `json:"product_id"`
+	Quantity  int `json:"quantity"`
+}
+
+func (h *Handlers) GetInventory(w http.ResponseWriter, r *http.Request) {
+	productID := mux.Vars(r)["product_id"]
+
+	result, err := h.Inventory.Get(r.Context(), productID)
+	if err != nil {
+		http.Error(w, "Product not found", http.StatusNotFound)
+		return
+	}
+
+	json.NewEncoder(w).Encode(result)
+}
+
+func (h *Handlers) CreateInventory(w http.ResponseWriter, r *http.Request) {
+	var req inventoryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if _, err := h.Inventory.Create(r.Context(), req.ProductID, req.Quantity); err != nil {
+		http.Error(w, "Insert failed", http.StatusBadRequest)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (h *Handlers) UpdateInventory(w http.ResponseWriter, r *http.Request) {
+	productIDStr := mux.Vars(r)["product_id"]
+
+	var req inventoryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	productID, err := strconv.Atoi(productIDStr)
+	if err != nil {
+		http.Error(w, "Invalid product_id", http.StatusBadRequest)
+		return
+	}
+
+	if _, err := h.Inventory.Update(r.Context(), productID, req.Quantity); err != nil {
+		http.Error(w, "Update failed", http.StatusBadRequest)
+		return
+	}
+}
@@ -0,0 +1,63 @@
+package httpapi
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/Gianguyen1234/inventory-service-golang/internal/logging"
+	"github.com/Gianguyen1234/inventory-service-golang/internal/metrics"
+)
+
+var metricsLogger = logging.With("http")
+
+// routeTemplate returns the route pattern (e.g. "/inventory/{product_id}")
+// a request matched, falling back to the raw path for unmatched requests
+// so metrics cardinality stays bounded.
+func routeTemplate(r *http.Request) string {
+	if route := mux.CurrentRoute(r); route != nil {
+		if tpl, err := route.GetPathTemplate(); err == nil {
+			return tpl
+		}
+	}
+	return r.URL.Path
+}
+
+// statusRecorder captures the status code a handler writes, since
+// http.ResponseWriter doesn't expose it otherwise.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (s *statusRecorder) WriteHeader(status int) {
+	s.status = status
+	s.ResponseWriter.WriteHeader(status)
+}
+
+// metricsMiddleware records inventory_http_requests_total and
+// inventory_http_request_duration_seconds for every request, labeled by
+// the matched route template rather than the raw path, and logs one
+// structured line per request carrying the same route/method/status plus
+// latency_ms, so request-level timing shows up in logs, not just metrics.
+func metricsMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+		next.ServeHTTP(rec, r)
+
+		route := routeTemplate(r)
+		duration := time.Since(start)
+		metrics.HTTPRequestDuration.WithLabelValues(route, r.Method).Observe(duration.Seconds())
+		metrics.HTTPRequestsTotal.WithLabelValues(route, r.Method, strconv.Itoa(rec.status)).Inc()
+
+		fields := []any{"route", route, "method", r.Method, "status", rec.status, "latency_ms", duration.Milliseconds()}
+		for name, value := range mux.Vars(r) {
+			fields = append(fields, name, value)
+		}
+		metricsLogger.Info("http request", fields...)
+	})
+}
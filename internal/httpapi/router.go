@@ -0,0 +1,50 @@
+package httpapi
+
+import (
+	"net/http"
+	"net/http/pprof"
+	"os"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/Gianguyen1234/inventory-service-golang/internal/runtime"
+)
+
+// NewRouter wires h's handlers onto the routes the service exposes. Set
+// ENABLE_PPROF=true to also mount net/http/pprof under /debug/pprof for
+// production profiling. POST and PUT always require a bearer JWT carrying
+// the matching runtime.Permissions entry; GET is public unless
+// REQUIRE_READ_AUTH=true.
+func NewRouter(h *Handlers) *mux.Router {
+	r := mux.NewRouter()
+	r.Use(corsMiddleware) // ✅ Middleware CORS chạy trước mọi route
+	r.Use(metricsMiddleware)
+
+	getInventory := http.Handler(http.HandlerFunc(h.GetInventory))
+	if os.Getenv("REQUIRE_READ_AUTH") == "true" {
+		getInventory = RequirePermission(runtime.PermissionInventoryRead)(getInventory)
+	}
+
+	r.Handle("/inventory/{product_id}", getInventory).Methods("GET")
+	r.Handle("/inventory/{product_id}", RequirePermission(runtime.PermissionInventoryUpdate)(http.HandlerFunc(h.UpdateInventory))).Methods("PUT")
+	r.Handle("/inventory", RequirePermission(runtime.PermissionInventoryCreate)(http.HandlerFunc(h.CreateInventory))).Methods("POST")
+
+	r.Handle("/metrics", promhttp.Handler()).Methods("GET")
+
+	if os.Getenv("ENABLE_PPROF") == "true" {
+		r.HandleFunc("/debug/pprof/", pprof.Index)
+		r.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+		r.HandleFunc("/debug/pprof/profile", pprof.Profile)
+		r.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+		r.HandleFunc("/debug/pprof/trace", pprof.Trace)
+		r.PathPrefix("/debug/pprof/").HandlerFunc(pprof.Index)
+	}
+
+	r.NotFoundHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		enableCORS(w)
+		http.Error(w, "404 Not Found", http.StatusNotFound)
+	})
+
+	return r
+}
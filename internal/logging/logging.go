@@ -0,0 +1,16 @@
+// Package logging provides the process-wide structured logger. Every line
+// is JSON so it can be shipped straight to a log aggregator, and every
+// logger returned by With is tagged with a "component" field for filtering.
+package logging
+
+import (
+	"log/slog"
+	"os"
+)
+
+var base = slog.New(slog.NewJSONHandler(os.Stdout, nil))
+
+// With returns a logger scoped to component (e.g. "http", "kafka", "db").
+func With(component string) *slog.Logger {
+	return base.With("component", component)
+}
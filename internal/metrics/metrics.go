@@ -0,0 +1,50 @@
+// Package metrics holds the Prometheus collectors shared by the HTTP and
+// Kafka paths. They're registered via promauto against the default
+// registry, which httpapi.NewRouter exposes on /metrics.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// HTTPRequestsTotal counts HTTP requests by route, method and status.
+	HTTPRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "inventory_http_requests_total",
+		Help: "Total HTTP requests processed.",
+	}, []string{"route", "method", "status"})
+
+	// HTTPRequestDuration observes HTTP request latency in seconds.
+	HTTPRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "inventory_http_request_duration_seconds",
+		Help:    "HTTP request latency in seconds.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"route", "method"})
+
+	// KafkaMessagesConsumedTotal counts consumed messages by topic and
+	// outcome ("ok", "dlq", "decode_error").
+	KafkaMessagesConsumedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "inventory_kafka_messages_consumed_total",
+		Help: "Total Kafka messages consumed.",
+	}, []string{"topic", "result"})
+
+	// KafkaPublishErrorsTotal counts failed publishes by topic.
+	KafkaPublishErrorsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "inventory_kafka_publish_errors_total",
+		Help: "Total Kafka publish errors.",
+	}, []string{"topic"})
+
+	// StockQuantity tracks the last known quantity per product.
+	StockQuantity = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "inventory_stock_quantity",
+		Help: "Current stock quantity per product.",
+	}, []string{"product_id"})
+
+	// ReservationsPending tracks reservations awaiting confirmation or
+	// release.
+	ReservationsPending = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "inventory_reservations_pending",
+		Help: "Number of stock reservations currently pending confirmation.",
+	})
+)
@@ -0,0 +1,43 @@
+// Package query is the read side of the service. It serves GetInventory
+// out of a Redis projection maintained by Projector, falling back to the
+// authoritative Postgres table on a cache miss.
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Gianguyen1234/inventory-service-golang/internal/domain"
+)
+
+// GetInventoryQuery asks for the current read model of a single product.
+type GetInventoryQuery struct {
+	ProductID string
+}
+
+// ChangeNotifier receives every read model Projector applies to the cache,
+// e.g. to fan it out to gRPC WatchInventory subscribers. It's expressed as
+// an interface here so query doesn't import the gRPC or service packages.
+type ChangeNotifier interface {
+	Publish(model domain.InventoryReadModel)
+}
+
+// Bus dispatches typed queries to their handler, mirroring command.Bus.
+type Bus struct {
+	store *Store
+}
+
+// NewBus builds a query Bus backed by store.
+func NewBus(store *Store) *Bus {
+	return &Bus{store: store}
+}
+
+// Dispatch routes q to its handler based on its concrete type.
+func (b *Bus) Dispatch(ctx context.Context, q any) (any, error) {
+	switch query := q.(type) {
+	case GetInventoryQuery:
+		return b.store.GetInventory(ctx, query.ProductID)
+	default:
+		return nil, fmt.Errorf("query: no handler registered for %T", q)
+	}
+}
@@ -0,0 +1,110 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/segmentio/kafka-go"
+
+	"github.com/Gianguyen1234/inventory-service-golang/internal/domain"
+	"github.com/Gianguyen1234/inventory-service-golang/internal/logging"
+	"github.com/Gianguyen1234/inventory-service-golang/internal/metrics"
+)
+
+var projectorLogger = logging.With("projector")
+
+// Projector consumes "inventory-changed" and keeps store's Redis projection
+// in sync with the authoritative writes made by the command side.
+type Projector struct {
+	store  *Store
+	notify ChangeNotifier
+}
+
+// NewProjector builds a Projector that writes into store. notify may be
+// nil, in which case updates are cached but not fanned out anywhere else
+// (the HTTP-only deployment mode).
+func NewProjector(store *Store, notify ChangeNotifier) *Projector {
+	return &Projector{store: store, notify: notify}
+}
+
+// Run reads inventory-changed events off Kafka forever, updating the
+// projection for each one. Used when BROKER=kafka (the default). Meant to
+// be started with `go projector.Run(brokers)`.
+func (p *Projector) Run(brokers []string) {
+	r := kafka.NewReader(kafka.ReaderConfig{
+		Brokers: brokers,
+		Topic:   "inventory-changed",
+		GroupID: "inventory-service-projector",
+	})
+
+	for {
+		m, err := r.ReadMessage(context.Background())
+		if err != nil {
+			projectorLogger.Error("read error", "error", err)
+			continue
+		}
+		_ = p.apply(m.Value, m.Topic)
+	}
+}
+
+// RunNats reads inventory-changed events off JetStream forever, updating
+// the projection for each one. Used when BROKER=nats, so the Redis
+// projection and gRPC WatchInventory fan-out stay live under that
+// deployment mode too, not just Kafka's.
+func (p *Projector) RunNats(ctx context.Context, js jetstream.JetStream) error {
+	stream, err := js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:     "INVENTORY_CHANGED",
+		Subjects: []string{"inventory-changed"},
+	})
+	if err != nil {
+		return err
+	}
+
+	consumer, err := stream.CreateOrUpdateConsumer(ctx, jetstream.ConsumerConfig{
+		Durable: "inventory-service-projector",
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = consumer.Consume(func(msg jetstream.Msg) {
+		if err := p.apply(msg.Data(), "inventory-changed"); err != nil {
+			_ = msg.Nak()
+			return
+		}
+		_ = msg.Ack()
+	})
+	return err
+}
+
+// apply decodes payload as an InventoryChangedEvent, writes it into store
+// and fans it out through notify. topic is only used to label metrics, so
+// the same logic serves both the Kafka and JetStream readers. The returned
+// error lets RunNats Nak a message so JetStream redelivers it instead of
+// silently dropping a projection update on a transient store failure; Run
+// has no such redelivery mechanism, so it only logs.
+func (p *Projector) apply(payload []byte, topic string) error {
+	var event domain.InventoryChangedEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		projectorLogger.Error("unmarshal error", "error", err)
+		metrics.KafkaMessagesConsumedTotal.WithLabelValues(topic, "decode_error").Inc()
+		return err
+	}
+
+	model := domain.InventoryReadModel{
+		ProductID: event.ProductID,
+		Quantity:  event.Quantity,
+		Available: event.Quantity > 0,
+	}
+	if err := p.store.Put(context.Background(), model); err != nil {
+		projectorLogger.Error("cache write error", "error", err, "product_id", event.ProductID)
+		metrics.KafkaMessagesConsumedTotal.WithLabelValues(topic, "cache_error").Inc()
+		return err
+	}
+	if p.notify != nil {
+		p.notify.Publish(model)
+	}
+	metrics.KafkaMessagesConsumedTotal.WithLabelValues(topic, "ok").Inc()
+	return nil
+}
@@ -0,0 +1,94 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/Gianguyen1234/inventory-service-golang/internal/db"
+	"github.com/Gianguyen1234/inventory-service-golang/internal/domain"
+)
+
+// cacheTTL is how long a projected row stays in Redis before it must be
+// refreshed from Postgres, in case a projection update is ever missed.
+const cacheTTL = 10 * time.Minute
+
+// Store serves InventoryReadModel values out of Redis, falling back to
+// Postgres on a cache miss. Projector keeps the cache warm.
+type Store struct {
+	redis *redis.Client
+}
+
+// NewStore builds a Store backed by the given Redis client.
+func NewStore(redisClient *redis.Client) *Store {
+	return &Store{redis: redisClient}
+}
+
+func cacheKey(productID string) string {
+	return "inventory:" + productID
+}
+
+// GetInventory returns the read model for productID, preferring the Redis
+// projection and falling back to the authoritative Postgres row.
+func (s *Store) GetInventory(ctx context.Context, productID string) (domain.InventoryReadModel, error) {
+	if model, err := s.fromCache(ctx, productID); err == nil {
+		return model, nil
+	}
+
+	model, err := s.fromPostgres(ctx, productID)
+	if err != nil {
+		return domain.InventoryReadModel{}, err
+	}
+
+	// Best-effort warm the cache; a failure here shouldn't fail the read.
+	_ = s.Put(ctx, model)
+	return model, nil
+}
+
+func (s *Store) fromCache(ctx context.Context, productID string) (domain.InventoryReadModel, error) {
+	data, err := s.redis.Get(ctx, cacheKey(productID)).Bytes()
+	if err != nil {
+		return domain.InventoryReadModel{}, err
+	}
+
+	var model domain.InventoryReadModel
+	if err := json.Unmarshal(data, &model); err != nil {
+		return domain.InventoryReadModel{}, err
+	}
+	return model, nil
+}
+
+func (s *Store) fromPostgres(ctx context.Context, productID string) (domain.InventoryReadModel, error) {
+	var quantity int
+	err := db.Pool.QueryRow(ctx,
+		`SELECT quantity FROM inventories WHERE product_id = $1`, productID).Scan(&quantity)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.InventoryReadModel{}, errors.New("product not found")
+	}
+	if err != nil {
+		return domain.InventoryReadModel{}, err
+	}
+
+	productIDInt, _ := strconv.Atoi(productID)
+	return domain.InventoryReadModel{
+		ProductID: productIDInt,
+		Quantity:  quantity,
+		Available: quantity > 0,
+	}, nil
+}
+
+// Put writes model into the Redis projection, overwriting whatever was
+// cached before. Called by Projector on every inventory-changed event.
+func (s *Store) Put(ctx context.Context, model domain.InventoryReadModel) error {
+	data, err := json.Marshal(model)
+	if err != nil {
+		return err
+	}
+	key := cacheKey(strconv.Itoa(model.ProductID))
+	return s.redis.Set(ctx, key, data, cacheTTL).Err()
+}
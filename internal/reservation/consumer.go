@@ -0,0 +1,53 @@
+package reservation
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/Gianguyen1234/inventory-service-golang/internal/domain"
+	"github.com/Gianguyen1234/inventory-service-golang/internal/metrics"
+)
+
+// ConsumeOrderConfirmed reads OrderConfirmedEvent off the "order-confirmed"
+// subject and confirms the matching reservation in ledger, so Watcher never
+// releases stock for an order that actually went through.
+func ConsumeOrderConfirmed(ctx context.Context, js jetstream.JetStream, ledger *Ledger) error {
+	stream, err := js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:     "ORDER_CONFIRMED",
+		Subjects: []string{"order-confirmed"},
+	})
+	if err != nil {
+		return err
+	}
+
+	consumer, err := stream.CreateOrUpdateConsumer(ctx, jetstream.ConsumerConfig{
+		Durable: "inventory-service-order-confirmed",
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = consumer.Consume(func(msg jetstream.Msg) {
+		var event domain.OrderConfirmedEvent
+		if err := json.Unmarshal(msg.Data(), &event); err != nil {
+			reservationLogger.Error("order-confirmed unmarshal error", "error", err)
+			metrics.KafkaMessagesConsumedTotal.WithLabelValues("order-confirmed", "decode_error").Inc()
+			_ = msg.Nak()
+			return
+		}
+
+		if err := ledger.Confirm(ctx, event.OrderID); err != nil {
+			reservationLogger.Error("reservation confirm failed", "error", err, "order_id", event.OrderID)
+			metrics.KafkaMessagesConsumedTotal.WithLabelValues("order-confirmed", "error").Inc()
+			_ = msg.Nak()
+			return
+		}
+
+		metrics.ReservationsPending.Dec()
+		metrics.KafkaMessagesConsumedTotal.WithLabelValues("order-confirmed", "ok").Inc()
+		_ = msg.Ack()
+	})
+	return err
+}
@@ -0,0 +1,111 @@
+// Package reservation backs the order-reservation flow with a JetStream KV
+// bucket, giving it a saga-style timeout (auto-release of stock that was
+// reserved but never confirmed) without needing a separate scheduler.
+package reservation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+var errNoPendingHistory = errors.New("reservation: no pending entry found in history")
+
+const bucketName = "inventory-reservations"
+
+// pendingEntry is the JSON value stored under "pending.<orderId>" for each
+// reservation that hasn't been confirmed or released yet.
+type pendingEntry struct {
+	ProductID  int       `json:"productId"`
+	Quantity   int       `json:"quantity"`
+	ReservedAt time.Time `json:"reservedAt"`
+}
+
+// Ledger tracks pending stock reservations in a JetStream KV bucket keyed
+// by orderId, with a bucket-wide per-key TTL. Confirm marks a reservation
+// settled; anything left pending past the TTL is picked up by Watcher.
+type Ledger struct {
+	kv jetstream.KeyValue
+}
+
+// NewLedger creates (or reuses) the "inventory-reservations" KV bucket with
+// the given TTL applied to every key written to it.
+func NewLedger(ctx context.Context, js jetstream.JetStream, ttl time.Duration) (*Ledger, error) {
+	kv, err := js.CreateOrUpdateKeyValue(ctx, jetstream.KeyValueConfig{
+		Bucket: bucketName,
+		TTL:    ttl,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Ledger{kv: kv}, nil
+}
+
+// Reserve records a pending reservation for orderID so Watcher can release
+// it automatically if it's never confirmed.
+func (l *Ledger) Reserve(ctx context.Context, orderID, productID, quantity int) error {
+	entry := pendingEntry{ProductID: productID, Quantity: quantity, ReservedAt: time.Now()}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	_, err = l.kv.Put(ctx, pendingKey(orderID), data)
+	return err
+}
+
+// Confirm marks orderID's reservation as settled. It writes a tombstone
+// rather than deleting the pending key outright, so Watcher can tell a
+// confirmed reservation apart from one that genuinely expired.
+func (l *Ledger) Confirm(ctx context.Context, orderID int) error {
+	_, err := l.kv.Put(ctx, confirmedKey(orderID), []byte("1"))
+	return err
+}
+
+// isConfirmed reports whether orderID was confirmed before its pending
+// entry expired.
+func (l *Ledger) isConfirmed(ctx context.Context, orderID int) bool {
+	_, err := l.kv.Get(ctx, confirmedKey(orderID))
+	return err == nil
+}
+
+// lastPending recovers the pendingEntry that existed right before expiry.
+// A TTL-driven delete/purge notification from JetStream carries no value,
+// so it's read back from the key's history instead.
+func (l *Ledger) lastPending(ctx context.Context, expired jetstream.KeyValueEntry) (pendingEntry, error) {
+	if len(expired.Value()) > 0 {
+		var entry pendingEntry
+		if err := json.Unmarshal(expired.Value(), &entry); err == nil {
+			return entry, nil
+		}
+	}
+
+	history, err := l.kv.History(ctx, expired.Key())
+	if err != nil {
+		return pendingEntry{}, err
+	}
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].Operation() != jetstream.KeyValuePut {
+			continue
+		}
+		var entry pendingEntry
+		if err := json.Unmarshal(history[i].Value(), &entry); err != nil {
+			return pendingEntry{}, err
+		}
+		return entry, nil
+	}
+	return pendingEntry{}, errNoPendingHistory
+}
+
+const pendingPrefix = "pending."
+
+func pendingKey(orderID int) string {
+	return pendingPrefix + strconv.Itoa(orderID)
+}
+
+func confirmedKey(orderID int) string {
+	return "confirmed." + strconv.Itoa(orderID)
+}
@@ -0,0 +1,60 @@
+package reservation
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/Gianguyen1234/inventory-service-golang/internal/command"
+	"github.com/Gianguyen1234/inventory-service-golang/internal/domain"
+	"github.com/Gianguyen1234/inventory-service-golang/internal/metrics"
+)
+
+// ConsumeOrderCreated is the NATS JetStream counterpart of
+// command.ConsumeOrderCreated, used when the deployment is configured with
+// BROKER=nats. It reads the "orders" subject and dispatches the same
+// ReserveStockCommand onto bus.
+func ConsumeOrderCreated(ctx context.Context, js jetstream.JetStream, bus *command.Bus) error {
+	stream, err := js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:     "ORDERS",
+		Subjects: []string{"orders"},
+	})
+	if err != nil {
+		return err
+	}
+
+	consumer, err := stream.CreateOrUpdateConsumer(ctx, jetstream.ConsumerConfig{
+		Durable: "inventory-service-group",
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = consumer.Consume(func(msg jetstream.Msg) {
+		var event domain.OrderCreatedEvent
+		if err := json.Unmarshal(msg.Data(), &event); err != nil {
+			reservationLogger.Error("json unmarshal error", "error", err)
+			metrics.KafkaMessagesConsumedTotal.WithLabelValues("orders", "decode_error").Inc()
+			_ = msg.Nak()
+			return
+		}
+
+		reservationLogger.Info("received OrderCreatedEvent", "order_id", event.OrderID, "product_id", event.ProductID)
+
+		cmd := command.ReserveStockCommand{
+			OrderID:   event.OrderID,
+			ProductID: event.ProductID,
+			Quantity:  event.Quantity,
+		}
+		if err := bus.Dispatch(ctx, cmd); err != nil {
+			reservationLogger.Error("reserve stock failed", "error", err, "order_id", event.OrderID)
+			metrics.KafkaMessagesConsumedTotal.WithLabelValues("orders", "error").Inc()
+			_ = msg.Nak()
+			return
+		}
+		metrics.KafkaMessagesConsumedTotal.WithLabelValues("orders", "ok").Inc()
+		_ = msg.Ack()
+	})
+	return err
+}
@@ -0,0 +1,80 @@
+package reservation
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/Gianguyen1234/inventory-service-golang/internal/logging"
+)
+
+var reservationLogger = logging.With("reservation")
+
+// ReleaseFunc is called once per reservation that expired without being
+// confirmed, so the caller can re-increment stock and emit inventory-released.
+type ReleaseFunc func(ctx context.Context, orderID, productID, quantity int) error
+
+// Watcher observes the ledger's KV bucket for pending entries that expired
+// (a Delete/Purge operation NATS generates once a key's TTL elapses) and
+// releases them unless Confirm already ran for that order.
+type Watcher struct {
+	ledger  *Ledger
+	release ReleaseFunc
+}
+
+// NewWatcher builds a Watcher that calls release for every unconfirmed
+// expiry observed on ledger.
+func NewWatcher(ledger *Ledger, release ReleaseFunc) *Watcher {
+	return &Watcher{ledger: ledger, release: release}
+}
+
+// Run watches the bucket forever. Meant to be started with `go watcher.Run(ctx)`.
+func (w *Watcher) Run(ctx context.Context) {
+	watch, err := w.ledger.kv.WatchAll(ctx)
+	if err != nil {
+		reservationLogger.Error("cannot start watch", "error", err)
+		return
+	}
+	defer watch.Stop()
+
+	for entry := range watch.Updates() {
+		if entry == nil {
+			continue // end of initial replay batch
+		}
+		if entry.Operation() == jetstream.KeyValuePut {
+			continue
+		}
+
+		orderID, ok := orderIDFromPendingKey(entry.Key())
+		if !ok {
+			continue // not a pending.<orderId> key (e.g. a confirmed.* tombstone)
+		}
+
+		if w.ledger.isConfirmed(ctx, orderID) {
+			continue
+		}
+
+		pending, err := w.ledger.lastPending(ctx, entry)
+		if err != nil {
+			reservationLogger.Error("cannot recover expired entry", "error", err, "order_id", orderID)
+			continue
+		}
+
+		if err := w.release(ctx, orderID, pending.ProductID, pending.Quantity); err != nil {
+			reservationLogger.Error("release failed", "error", err, "order_id", orderID)
+		}
+	}
+}
+
+func orderIDFromPendingKey(key string) (int, bool) {
+	if !strings.HasPrefix(key, pendingPrefix) {
+		return 0, false
+	}
+	orderID, err := strconv.Atoi(strings.TrimPrefix(key, pendingPrefix))
+	if err != nil {
+		return 0, false
+	}
+	return orderID, true
+}
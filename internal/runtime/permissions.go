@@ -0,0 +1,33 @@
+// Package runtime holds small process-wide registries shared across
+// transports. Today that's just the permission strings write endpoints
+// require, issued as entries of a bearer JWT's "permissions" claim.
+package runtime
+
+// The permission strings this service recognizes. Handlers reference these
+// constants rather than string literals so a typo fails to compile instead
+// of silently gating nothing.
+const (
+	PermissionInventoryCreate = "inventory:create"
+	PermissionInventoryUpdate = "inventory:update"
+	PermissionInventoryRead   = "inventory:read"
+)
+
+// Permissions is the registry of every permission string this service
+// understands. It exists so the set is declared in one place even though,
+// today, Has only checks a token's claims against one specific permission
+// at a time rather than validating the whole claim against this registry.
+var Permissions = map[string]bool{
+	PermissionInventoryCreate: true,
+	PermissionInventoryUpdate: true,
+	PermissionInventoryRead:   true,
+}
+
+// Has reports whether granted includes permission.
+func Has(granted []string, permission string) bool {
+	for _, g := range granted {
+		if g == permission {
+			return true
+		}
+	}
+	return false
+}
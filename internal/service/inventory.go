@@ -0,0 +1,65 @@
+// Package service holds the business logic shared by every transport
+// (HTTP and gRPC). It is a thin wrapper over command.Bus and query.Bus: the
+// transports decode/encode their wire format and call into Inventory, so
+// neither one can drift from the other's semantics.
+package service
+
+import (
+	"context"
+
+	"github.com/Gianguyen1234/inventory-service-golang/internal/command"
+	"github.com/Gianguyen1234/inventory-service-golang/internal/domain"
+	"github.com/Gianguyen1234/inventory-service-golang/internal/query"
+)
+
+// Inventory dispatches onto the command and query buses on behalf of
+// whichever transport is calling it.
+type Inventory struct {
+	Commands *command.Bus
+	Queries  *query.Bus
+}
+
+// New builds an Inventory backed by the given buses.
+func New(commands *command.Bus, queries *query.Bus) *Inventory {
+	return &Inventory{Commands: commands, Queries: queries}
+}
+
+// Get returns the read model for productID.
+func (i *Inventory) Get(ctx context.Context, productID string) (domain.InventoryReadModel, error) {
+	result, err := i.Queries.Dispatch(ctx, query.GetInventoryQuery{ProductID: productID})
+	if err != nil {
+		return domain.InventoryReadModel{}, err
+	}
+	return result.(domain.InventoryReadModel), nil
+}
+
+// Create inserts a brand new inventory row for productID, returning the
+// resulting read model.
+func (i *Inventory) Create(ctx context.Context, productID, quantity int) (domain.InventoryReadModel, error) {
+	cmd := command.CreateInventoryCommand{ProductID: productID, Quantity: quantity}
+	if err := i.Commands.Dispatch(ctx, cmd); err != nil {
+		return domain.InventoryReadModel{}, err
+	}
+	return resultModel(productID, quantity), nil
+}
+
+// Update overwrites the quantity for an existing product, returning the
+// resulting read model.
+func (i *Inventory) Update(ctx context.Context, productID, quantity int) (domain.InventoryReadModel, error) {
+	cmd := command.AdjustQuantityCommand{ProductID: productID, Quantity: quantity}
+	if err := i.Commands.Dispatch(ctx, cmd); err != nil {
+		return domain.InventoryReadModel{}, err
+	}
+	return resultModel(productID, quantity), nil
+}
+
+// resultModel builds the read model a successful Create/Update just wrote,
+// applying the same "available = quantity > 0" rule query.Store uses so
+// every transport agrees on it in one place.
+func resultModel(productID, quantity int) domain.InventoryReadModel {
+	return domain.InventoryReadModel{
+		ProductID: productID,
+		Quantity:  quantity,
+		Available: quantity > 0,
+	}
+}
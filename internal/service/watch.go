@@ -0,0 +1,72 @@
+package service
+
+import (
+	"sync"
+
+	"github.com/Gianguyen1234/inventory-service-golang/internal/domain"
+)
+
+// watchBuffer is how many unread updates a WatchInventory subscriber may
+// queue before Publish starts dropping the oldest one to make room for the
+// newest. A slow subscriber sees gaps rather than stalling the publisher.
+const watchBuffer = 8
+
+// Hub fans out InventoryReadModel updates to WatchInventory subscribers,
+// keyed by product ID. query.Projector implements the publishing side via
+// the ChangeNotifier interface; internal/grpcapi is the only subscriber
+// today.
+type Hub struct {
+	mu   sync.Mutex
+	subs map[int]map[chan domain.InventoryReadModel]struct{}
+}
+
+// NewHub builds an empty Hub.
+func NewHub() *Hub {
+	return &Hub{subs: make(map[int]map[chan domain.InventoryReadModel]struct{})}
+}
+
+// Subscribe registers a new bounded channel for productID's updates. Callers
+// must invoke the returned cancel func once they stop reading, or the
+// channel leaks for the life of the Hub.
+func (h *Hub) Subscribe(productID int) (<-chan domain.InventoryReadModel, func()) {
+	ch := make(chan domain.InventoryReadModel, watchBuffer)
+
+	h.mu.Lock()
+	if h.subs[productID] == nil {
+		h.subs[productID] = make(map[chan domain.InventoryReadModel]struct{})
+	}
+	h.subs[productID][ch] = struct{}{}
+	h.mu.Unlock()
+
+	cancel := func() {
+		h.mu.Lock()
+		delete(h.subs[productID], ch)
+		if len(h.subs[productID]) == 0 {
+			delete(h.subs, productID)
+		}
+		h.mu.Unlock()
+	}
+	return ch, cancel
+}
+
+// Publish fans model out to every subscriber watching its product, dropping
+// the oldest queued update for any subscriber that isn't keeping up.
+func (h *Hub) Publish(model domain.InventoryReadModel) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch := range h.subs[model.ProductID] {
+		select {
+		case ch <- model:
+		default:
+			select {
+			case <-ch:
+			default:
+			}
+			select {
+			case ch <- model:
+			default:
+			}
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package transport
+
+import (
+	"context"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// KafkaPublisher adapts a *kafka.Writer to Publisher.
+type KafkaPublisher struct {
+	writer *kafka.Writer
+}
+
+// NewKafkaPublisher builds a KafkaPublisher that writes to brokers, picking
+// the destination topic per message.
+func NewKafkaPublisher(brokers []string) *KafkaPublisher {
+	return &KafkaPublisher{
+		writer: &kafka.Writer{
+			Addr:     kafka.TCP(brokers...),
+			Balancer: &kafka.LeastBytes{},
+		},
+	}
+}
+
+// Publish writes payload to topic.
+func (p *KafkaPublisher) Publish(ctx context.Context, topic string, payload []byte) error {
+	return p.writer.WriteMessages(ctx, kafka.Message{Topic: topic, Value: payload})
+}
@@ -0,0 +1,24 @@
+package transport
+
+import (
+	"context"
+
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// NatsPublisher adapts a jetstream.JetStream to Publisher, publishing each
+// topic as a JetStream subject of the same name.
+type NatsPublisher struct {
+	js jetstream.JetStream
+}
+
+// NewNatsPublisher builds a NatsPublisher backed by js.
+func NewNatsPublisher(js jetstream.JetStream) *NatsPublisher {
+	return &NatsPublisher{js: js}
+}
+
+// Publish publishes payload to the JetStream subject named topic.
+func (p *NatsPublisher) Publish(ctx context.Context, topic string, payload []byte) error {
+	_, err := p.js.Publish(ctx, topic, payload)
+	return err
+}
@@ -0,0 +1,12 @@
+// Package transport abstracts the message broker so the rest of the service
+// can publish events without caring whether deployments run Kafka or NATS
+// JetStream.
+package transport
+
+import "context"
+
+// Publisher publishes a payload to a named topic/subject. Both KafkaPublisher
+// and NatsPublisher implement it, selected at startup via the BROKER env var.
+type Publisher interface {
+	Publish(ctx context.Context, topic string, payload []byte) error
+}